@@ -0,0 +1,230 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"os/signal"
+	"strings"
+	"text/tabwriter"
+	"text/template"
+
+	"github.com/spf13/cobra"
+)
+
+// newListCmd prints a human-readable table of current sessions.
+func newListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "Print a table of current sessions",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg := loadEffectiveConfig(cmd)
+			sessions, err := LoadSessions(cfg.sessionsDirs()...)
+			if err != nil {
+				return err
+			}
+			return printSessionTable(os.Stdout, sessions)
+		},
+	}
+}
+
+// printSessionTable writes sessions as an aligned table to w.
+func printSessionTable(w io.Writer, sessions []Session) error {
+	tw := tabwriter.NewWriter(w, 0, 2, 2, ' ', 0)
+	fmt.Fprintln(tw, "STATUS\tTITLE\tTTY\tLAST ACTIVITY")
+	for _, s := range sessions {
+		st := s.GetStatus()
+		observeSessionStatus(st)
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", statusLabel(st), s.Title(), s.TTY, timeAgo(s.Time()))
+	}
+	return tw.Flush()
+}
+
+// statusLabel returns the lowercase name used for a Status outside the TUI
+// (table columns, watch output, CloudEvent payloads, metric labels).
+func statusLabel(st Status) string {
+	switch st {
+	case StatusActive:
+		return "active"
+	case StatusWaiting:
+		return "waiting"
+	case StatusPermission:
+		return "permission"
+	default:
+		return "idle"
+	}
+}
+
+// newJSONCmd streams LoadSessions as line-delimited JSON, for piping to jq
+// or a status bar.
+func newJSONCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "json",
+		Short: "Stream sessions as line-delimited JSON",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg := loadEffectiveConfig(cmd)
+			sessions, err := LoadSessions(cfg.sessionsDirs()...)
+			if err != nil {
+				return err
+			}
+			enc := json.NewEncoder(os.Stdout)
+			for _, s := range sessions {
+				if err := enc.Encode(s); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+}
+
+// newDismissCmd removes an idle session's backing file, mirroring the TUI's
+// "d" binding.
+func newDismissCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "dismiss <session-id>",
+		Short: "Dismiss a session (like pressing d in the TUI)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg := loadEffectiveConfig(cmd)
+			s, err := findSessionByID(cfg.sessionsDirs(), args[0])
+			if err != nil {
+				return err
+			}
+			if s.GetStatus() != StatusIdle {
+				return fmt.Errorf("session %s is not idle", args[0])
+			}
+			if s.FileName == "" {
+				return fmt.Errorf("session %s has no backing file", args[0])
+			}
+			return os.Remove(s.FileName)
+		},
+	}
+}
+
+// newFocusCmd focuses the terminal tab/pane/window owning a session,
+// mirroring the TUI's "enter"/"f" binding.
+func newFocusCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "focus <session-id>",
+		Short: "Focus a session's terminal (like pressing enter in the TUI)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg := loadEffectiveConfig(cmd)
+			s, err := findSessionByID(cfg.sessionsDirs(), args[0])
+			if err != nil {
+				return err
+			}
+			return FocusSession(s)
+		},
+	}
+}
+
+// findSessionByID loads sessions from dirs and returns the one matching id.
+func findSessionByID(dirs []string, id string) (Session, error) {
+	sessions, err := LoadSessions(dirs...)
+	if err != nil {
+		return Session{}, err
+	}
+	for _, s := range sessions {
+		if s.SessionID == id {
+			return s, nil
+		}
+	}
+	return Session{}, fmt.Errorf("no session with id %q", id)
+}
+
+// flagWatchExec holds the --exec template for newWatchCmd.
+var flagWatchExec string
+
+// newWatchCmd runs a template command whenever a session's status changes.
+func newWatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "watch",
+		Short: "Run a command on every session status transition",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg := loadEffectiveConfig(cmd)
+			applyConfig(cfg)
+			if flagMetricsAddr != "" {
+				StartMetricsServer(flagMetricsAddr)
+			}
+			if len(cfg.EventSinks) > 0 {
+				globalEventBus = NewEventBusFromSpecs(cfg.EventSinks)
+			}
+			return runWatch(cfg, flagWatchExec)
+		},
+	}
+	cmd.Flags().StringVar(&flagWatchExec, "exec", "", `shell command template run on each transition, e.g. 'notify-send {{.Title}}'`)
+	return cmd
+}
+
+// runWatch watches cfg's sessions dir and reports status transitions
+// (idle<->waiting<->active<->permission) until interrupted, optionally
+// running execTemplate (a text/template against Session) for each.
+func runWatch(cfg Config, execTemplate string) error {
+	var tmpl *template.Template
+	if execTemplate != "" {
+		var err error
+		if tmpl, err = template.New("watch").Parse(execTemplate); err != nil {
+			return err
+		}
+	}
+
+	prev := map[string]Status{}
+	report := func(seedOnly bool) {
+		sessions, err := LoadSessions(cfg.sessionsDirs()...)
+		if err != nil {
+			return
+		}
+		next := make(map[string]Status, len(sessions))
+		for _, s := range sessions {
+			st := s.GetStatus()
+			observeSessionStatus(st)
+			next[s.SessionID] = st
+			if was, ok := prev[s.SessionID]; !seedOnly && (!ok || was != st) {
+				wasLabel := "new"
+				if ok {
+					wasLabel = statusLabel(was)
+				}
+				fmt.Printf("%s %s -> %s (%s)\n", s.SessionID, wasLabel, statusLabel(st), s.Title())
+				if tmpl != nil {
+					runWatchExec(tmpl, s)
+				}
+				if ok {
+					observeSessionTransition(was, st)
+					globalEventBus.EmitSessionTransition(s, was, st)
+				}
+			}
+		}
+		prev = next
+	}
+	report(true) // seed prev so the first real diff isn't "every session transitioned"
+
+	closer, err := WatchSessions(cfg.sessionsDirs(), func() { report(false) })
+	if err != nil {
+		return err
+	}
+	defer closer.Close()
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, os.Interrupt)
+	<-sigCh
+	return nil
+}
+
+// runWatchExec renders tmpl against s and runs the result through the shell.
+func runWatchExec(tmpl *template.Template, s Session) {
+	var buf strings.Builder
+	if err := tmpl.Execute(&buf, s); err != nil {
+		fmt.Fprintf(os.Stderr, "watch: template error: %v\n", err)
+		return
+	}
+	cmd := exec.Command("sh", "-c", buf.String())
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		fmt.Fprintf(os.Stderr, "watch: exec error: %v\n", err)
+	}
+}
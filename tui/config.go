@@ -0,0 +1,141 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config holds user overrides for the monitor, loaded from
+// $XDG_CONFIG_HOME/claude-monitor/config.yaml (or the path given via
+// --config) and merged with CLI flags over defaults in main.go.
+type Config struct {
+	SessionsDirs      []string      `yaml:"sessions_dirs"`
+	IdleThreshold     time.Duration `yaml:"idle_threshold"`
+	WatchDebounce     time.Duration `yaml:"watch_debounce"`
+	StalePollInterval time.Duration `yaml:"stale_poll_interval"`
+	EventSinks        []string      `yaml:"event_sinks"`
+	KeyBindings       KeyMap        `yaml:"key_bindings"`
+	Theme             ThemeConfig   `yaml:"theme"`
+}
+
+// ThemeConfig overrides the lipgloss color palette. Empty string fields keep
+// the built-in default for that color. Light switches the baseline palette
+// to a light-background-friendly set before the per-color overrides (if any)
+// are applied on top.
+type ThemeConfig struct {
+	Light  bool   `yaml:"light"`
+	Orange string `yaml:"orange"`
+	Green  string `yaml:"green"`
+	Red    string `yaml:"red"`
+	Gray   string `yaml:"gray"`
+	White  string `yaml:"white"`
+	Dim    string `yaml:"dim"`
+	Accent string `yaml:"accent"`
+}
+
+// KeyMap maps an action name to the keys (as reported by tea.KeyMsg.String())
+// that trigger it, letting config.yaml remap the navigate/focus/dismiss/quit
+// bindings handled in Model.Update.
+type KeyMap map[string][]string
+
+// Action returns the action bound to key, or "" if no binding matches.
+func (k KeyMap) Action(key string) string {
+	for action, keys := range k {
+		for _, bound := range keys {
+			if bound == key {
+				return action
+			}
+		}
+	}
+	return ""
+}
+
+// DefaultKeyMap reproduces the monitor's original hard-coded bindings.
+func DefaultKeyMap() KeyMap {
+	return KeyMap{
+		"up":      {"up", "j"},
+		"down":    {"down", "k"},
+		"enter":   {"enter", "f"},
+		"dismiss": {"d"},
+		"quit":    {"q", "ctrl+c"},
+	}
+}
+
+// defaultSessionsDir is the directory watched when no config or flag
+// overrides it.
+func defaultSessionsDir() string {
+	return os.ExpandEnv("$HOME/.claude/monitor/sessions")
+}
+
+// DefaultConfig returns the monitor's built-in defaults.
+func DefaultConfig() Config {
+	return Config{
+		SessionsDirs:      []string{defaultSessionsDir()},
+		IdleThreshold:     30 * time.Second,
+		WatchDebounce:     300 * time.Millisecond,
+		StalePollInterval: 30 * time.Second,
+		KeyBindings:       DefaultKeyMap(),
+	}
+}
+
+// ConfigPath returns the default config file location, honoring
+// $XDG_CONFIG_HOME.
+func ConfigPath() string {
+	if dir := os.Getenv("XDG_CONFIG_HOME"); dir != "" {
+		return filepath.Join(dir, "claude-monitor", "config.yaml")
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return filepath.Join(".config", "claude-monitor", "config.yaml")
+	}
+	return filepath.Join(home, ".config", "claude-monitor", "config.yaml")
+}
+
+// LoadConfig reads and parses path, returning DefaultConfig() unchanged if
+// the file does not exist.
+func LoadConfig(path string) (Config, error) {
+	cfg := DefaultConfig()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return cfg, nil
+		}
+		return cfg, err
+	}
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return cfg, err
+	}
+	if len(cfg.KeyBindings) == 0 {
+		cfg.KeyBindings = DefaultKeyMap()
+	}
+	return cfg, nil
+}
+
+// sessionsDirs returns the configured sessions directories, falling back to
+// the built-in default when none are set.
+func (c Config) sessionsDirs() []string {
+	if len(c.SessionsDirs) > 0 {
+		return c.SessionsDirs
+	}
+	return []string{defaultSessionsDir()}
+}
+
+// applyConfig applies cfg's runtime tunables (idle threshold, watch
+// debounce, stale poll interval, theme) to the package-level state they
+// govern. It must run once before Model/WatchSessions are constructed.
+func applyConfig(cfg Config) {
+	if cfg.IdleThreshold > 0 {
+		idleThreshold = cfg.IdleThreshold
+	}
+	if cfg.WatchDebounce > 0 {
+		watchDebounce = cfg.WatchDebounce
+	}
+	if cfg.StalePollInterval > 0 {
+		watchStalePollInterval = cfg.StalePollInterval
+	}
+	applyTheme(cfg.Theme)
+}
@@ -0,0 +1,135 @@
+//go:build !integration
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+func TestLoadConfig_MissingFileReturnsDefault(t *testing.T) {
+	cfg, err := LoadConfig(filepath.Join(t.TempDir(), "does-not-exist.yaml"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := DefaultConfig()
+	if cfg.IdleThreshold != want.IdleThreshold {
+		t.Errorf("IdleThreshold = %v, want default %v", cfg.IdleThreshold, want.IdleThreshold)
+	}
+	if len(cfg.KeyBindings) != len(want.KeyBindings) {
+		t.Errorf("KeyBindings = %v, want default %v", cfg.KeyBindings, want.KeyBindings)
+	}
+}
+
+func TestLoadConfig_FileOverridesDefault(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte("idle_threshold: 90s\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := LoadConfig(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.IdleThreshold != 90*time.Second {
+		t.Errorf("IdleThreshold = %v, want 90s", cfg.IdleThreshold)
+	}
+}
+
+// TestLoadConfig_EmptyKeyBindingsFallsBackToDefault pins config.go:112: a
+// config file that sets key_bindings to nothing (which unmarshals to a nil
+// map) must not leave the monitor with no navigate/focus/dismiss/quit
+// bindings at all.
+func TestLoadConfig_EmptyKeyBindingsFallsBackToDefault(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte("idle_threshold: 5s\nkey_bindings:\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := LoadConfig(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := DefaultKeyMap()
+	if len(cfg.KeyBindings) != len(want) {
+		t.Fatalf("KeyBindings = %v, want default %v", cfg.KeyBindings, want)
+	}
+	if cfg.KeyBindings.Action("q") != "quit" {
+		t.Errorf(`KeyBindings.Action("q") = %q, want "quit"`, cfg.KeyBindings.Action("q"))
+	}
+}
+
+func TestKeyMap_Action(t *testing.T) {
+	k := DefaultKeyMap()
+	if got := k.Action("j"); got != "up" {
+		t.Errorf(`Action("j") = %q, want "up"`, got)
+	}
+	if got := k.Action("z"); got != "" {
+		t.Errorf(`Action("z") = %q, want ""`, got)
+	}
+}
+
+// newTestRootCmd builds a cobra.Command wired with the persistent flags
+// main() registers (all but --quiet), so loadEffectiveConfig's cmd.Flags().Changed(...)
+// checks behave as they would for a real invocation.
+func newTestRootCmd() *cobra.Command {
+	cmd := &cobra.Command{Use: "test"}
+	cmd.Flags().StringVar(&flagConfigPath, "config", "", "")
+	cmd.Flags().StringSliceVar(&flagSessionsDirs, "sessions-dir", nil, "")
+	cmd.Flags().DurationVar(&flagIdleThreshold, "idle-threshold", 0, "")
+	cmd.Flags().DurationVar(&flagWatchDebounce, "watch-debounce", 0, "")
+	cmd.Flags().BoolVar(&flagThemeLight, "light", false, "")
+	cmd.Flags().StringVar(&flagMetricsAddr, "metrics-addr", "", "")
+	cmd.Flags().DurationVar(&flagStalePoll, "stale-poll-interval", 0, "")
+	cmd.Flags().StringSliceVar(&flagEventSinks, "event-sink", nil, "")
+	return cmd
+}
+
+// resetConfigFlags restores the package-level flag vars loadEffectiveConfig
+// reads from, so tests don't leak flag state into each other.
+func resetConfigFlags() {
+	flagConfigPath = ""
+	flagSessionsDirs = nil
+	flagIdleThreshold = 0
+	flagWatchDebounce = 0
+	flagThemeLight = false
+	flagMetricsAddr = ""
+	flagStalePoll = 0
+	flagEventSinks = nil
+}
+
+// TestLoadEffectiveConfig_FlagOverridesFileOverridesDefault pins the merge
+// precedence documented on loadEffectiveConfig: flag > file > default.
+func TestLoadEffectiveConfig_FlagOverridesFileOverridesDefault(t *testing.T) {
+	defer resetConfigFlags()
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte("idle_threshold: 90s\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	// No flag set: file value (90s) wins over the 30s default.
+	cmd := newTestRootCmd()
+	flagConfigPath = path
+	if err := cmd.ParseFlags(nil); err != nil {
+		t.Fatal(err)
+	}
+	cfg := loadEffectiveConfig(cmd)
+	if cfg.IdleThreshold != 90*time.Second {
+		t.Errorf("IdleThreshold = %v, want file value 90s", cfg.IdleThreshold)
+	}
+
+	// Flag set: flag value wins over the file's 90s.
+	resetConfigFlags()
+	cmd = newTestRootCmd()
+	flagConfigPath = path
+	if err := cmd.ParseFlags([]string{"--idle-threshold=5s"}); err != nil {
+		t.Fatal(err)
+	}
+	cfg = loadEffectiveConfig(cmd)
+	if cfg.IdleThreshold != 5*time.Second {
+		t.Errorf("IdleThreshold = %v, want flag value 5s", cfg.IdleThreshold)
+	}
+}
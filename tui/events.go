@@ -0,0 +1,230 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CloudEvent is a CNCF CloudEvents v1.0 envelope, JSON-encoded. See
+// https://github.com/cloudevents/spec/blob/v1.0.2/cloudevents/spec.md.
+type CloudEvent struct {
+	SpecVersion     string      `json:"specversion"`
+	ID              string      `json:"id"`
+	Source          string      `json:"source"`
+	Type            string      `json:"type"`
+	Time            string      `json:"time"`
+	DataContentType string      `json:"datacontenttype"`
+	Data            interface{} `json:"data"`
+}
+
+// SessionTransitionData is the CloudEvent data payload for
+// dev.claude.session.* events.
+type SessionTransitionData struct {
+	SessionID string `json:"session_id"`
+	TTY       string `json:"tty"`
+	CWD       string `json:"cwd"`
+	From      string `json:"from"`
+	To        string `json:"to"`
+}
+
+// FocusAttemptData is the CloudEvent data payload for
+// dev.claude.focus.attempted events.
+type FocusAttemptData struct {
+	SessionID string `json:"session_id"`
+	Strategy  string `json:"strategy"`
+	Result    string `json:"result"`
+}
+
+// eventSink is a destination for outbound CloudEvents.
+type eventSink interface {
+	Send(CloudEvent) error
+}
+
+// globalEventBus is the process-wide event bus. It starts as a no-op
+// (nil sinks) and is replaced by main.go once --event-sink flags/config are
+// known, so every call site can emit unconditionally.
+var globalEventBus = NewEventBus(eventBusSource())
+
+// EventBus fans a CloudEvent out to every registered sink. A zero-value
+// EventBus (nil sinks) is a safe no-op, so callers don't need a nil check.
+type EventBus struct {
+	mu     sync.Mutex
+	source string
+	nextID int
+	sinks  []eventSink
+}
+
+// NewEventBus creates an EventBus whose events carry source as the
+// CloudEvents "source" attribute (conventionally host+PID, so a consumer can
+// tell which monitor instance an event came from).
+func NewEventBus(source string, sinks ...eventSink) *EventBus {
+	return &EventBus{source: source, sinks: sinks}
+}
+
+// emit builds a CloudEvent of the given type/data and sends it to every
+// sink, logging (not failing) on a sink error since event delivery is
+// best-effort.
+func (b *EventBus) emit(eventType string, data interface{}) {
+	if b == nil || len(b.sinks) == 0 {
+		return
+	}
+	b.mu.Lock()
+	b.nextID++
+	id := fmt.Sprintf("%s-%d", b.source, b.nextID)
+	b.mu.Unlock()
+
+	ev := CloudEvent{
+		SpecVersion:     "1.0",
+		ID:              id,
+		Source:          b.source,
+		Type:            eventType,
+		Time:            time.Now().UTC().Format(time.RFC3339Nano),
+		DataContentType: "application/json",
+		Data:            data,
+	}
+	for _, sink := range b.sinks {
+		if err := sink.Send(ev); err != nil {
+			log.Printf("events: sink error: %v", err)
+		}
+	}
+}
+
+// EmitSessionTransition emits a dev.claude.session.<status> event for s
+// moving from `from` to `to`.
+func (b *EventBus) EmitSessionTransition(s Session, from, to Status) {
+	b.emit(sessionEventType(to), SessionTransitionData{
+		SessionID: s.SessionID,
+		TTY:       s.TTY,
+		CWD:       s.CWD,
+		From:      statusLabel(from),
+		To:        statusLabel(to),
+	})
+}
+
+// EmitFocusAttempted emits a dev.claude.focus.attempted event for one
+// FocusSession attempt. strategy is the detected terminal backend (e.g.
+// "ghostty"); result is that backend's reported outcome.
+func (b *EventBus) EmitFocusAttempted(sessionID, strategy, result string) {
+	b.emit("dev.claude.focus.attempted", FocusAttemptData{
+		SessionID: sessionID,
+		Strategy:  strategy,
+		Result:    result,
+	})
+}
+
+// sessionEventType maps a Status to its dev.claude.session.* CloudEvent
+// type. GetStatus does not distinguish a freshly-idle session from one that
+// has gone stale past idleThreshold (both are StatusIdle), so "stale" and
+// "idle" share the same event type here.
+func sessionEventType(st Status) string {
+	switch st {
+	case StatusActive:
+		return "dev.claude.session.active"
+	case StatusWaiting:
+		return "dev.claude.session.waiting"
+	case StatusPermission:
+		return "dev.claude.session.permission"
+	default:
+		return "dev.claude.session.idle"
+	}
+}
+
+// eventBusSource returns the CloudEvents "source" attribute identifying this
+// monitor instance: hostname+PID.
+func eventBusSource() string {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "unknown"
+	}
+	return fmt.Sprintf("claude-monitor://%s/%d", host, os.Getpid())
+}
+
+// stdoutSink writes each event as a line of JSON to stdout, for piping to jq.
+type stdoutSink struct{}
+
+func (stdoutSink) Send(ev CloudEvent) error {
+	enc := json.NewEncoder(os.Stdout)
+	return enc.Encode(ev)
+}
+
+// webhookSink POSTs each event as JSON to an HTTP endpoint.
+type webhookSink struct {
+	url string
+}
+
+func (w webhookSink) Send(ev CloudEvent) error {
+	data, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, w.url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/cloudevents+json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// unixSocketSink writes each event as a line of JSON to a Unix domain
+// socket, dialing fresh for every send since the listener (e.g. a Stream
+// Deck plugin) may come and go independently of the monitor.
+type unixSocketSink struct {
+	path string
+}
+
+func (u unixSocketSink) Send(ev CloudEvent) error {
+	conn, err := net.Dial("unix", u.path)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	data, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+	_, err = conn.Write(data)
+	return err
+}
+
+// parseEventSink builds an eventSink from a config/flag entry: "stdout",
+// "unix:<path>", or an http(s):// webhook URL.
+func parseEventSink(spec string) (eventSink, error) {
+	switch {
+	case spec == "stdout":
+		return stdoutSink{}, nil
+	case strings.HasPrefix(spec, "unix:"):
+		return unixSocketSink{path: strings.TrimPrefix(spec, "unix:")}, nil
+	case strings.HasPrefix(spec, "http://"), strings.HasPrefix(spec, "https://"):
+		return webhookSink{url: spec}, nil
+	default:
+		return nil, fmt.Errorf("unrecognised event sink %q (want stdout, unix:<path>, or an http(s) URL)", spec)
+	}
+}
+
+// NewEventBusFromSpecs builds an EventBus from sink specs as accepted by
+// parseEventSink, skipping (and logging) any that fail to parse.
+func NewEventBusFromSpecs(specs []string) *EventBus {
+	var sinks []eventSink
+	for _, spec := range specs {
+		sink, err := parseEventSink(spec)
+		if err != nil {
+			log.Printf("events: %v", err)
+			continue
+		}
+		sinks = append(sinks, sink)
+	}
+	return NewEventBus(eventBusSource(), sinks...)
+}
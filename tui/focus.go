@@ -194,13 +194,18 @@ func realDeps() focusDeps {
 	}
 }
 
-// focusGhosttyTab is the testable core logic.
-func focusGhosttyTab(deps focusDeps, tty, cwd string) error {
+// focusGhosttyTab is the testable core logic. The returned result is
+// "<strategy>:<outcome>" (e.g. "keystroke:success", "osascript:fallback"),
+// the same strategy/outcome pair recorded via observeFocusAttempt, so a
+// caller building a CloudEvent can report what actually happened instead of
+// inferring it from the always-nil error below.
+func focusGhosttyTab(deps focusDeps, tty, cwd string) (result string, err error) {
 	cwdBasename := lastPathComponent(cwd)
+	start := time.Now()
 
 	// Strategy 1: TTY → tab index → Cmd+N (fast path, no sleep needed)
 	if deps.getGhosttyPID != nil && deps.sendKeyNToTab != nil && tty != "" {
-		if pid, err := deps.getGhosttyPID(); err == nil && pid > 0 {
+		if pid, pidErr := deps.getGhosttyPID(); pidErr == nil && pid > 0 {
 			var idx int
 			if deps.findTabIndex != nil {
 				idx = deps.findTabIndex(pid, tty)
@@ -208,9 +213,11 @@ func focusGhosttyTab(deps focusDeps, tty, cwd string) error {
 				idx = realFindTabIndex(pid, tty)
 			}
 			if idx >= 1 && idx <= 9 {
-				if err := deps.sendKeyNToTab(idx); err == nil {
-					return nil
+				if deps.sendKeyNToTab(idx) == nil {
+					observeFocusAttempt("keystroke", "success", time.Since(start))
+					return "keystroke:success", nil
 				}
+				observeFocusAttempt("keystroke", "error", time.Since(start))
 			}
 		}
 	}
@@ -223,14 +230,19 @@ func focusGhosttyTab(deps focusDeps, tty, cwd string) error {
 		}
 	}
 	if err := deps.focusTab(cwdBasename); err != nil {
-		_ = deps.activateApp()
-		return nil
+		if aerr := deps.activateApp(); aerr != nil {
+			observeFocusAttempt("osascript", "error", time.Since(start))
+			return "osascript:error", nil
+		}
+		observeFocusAttempt("osascript", "fallback", time.Since(start))
+		return "osascript:fallback", nil
 	}
-	return nil
+	observeFocusAttempt("osascript", "success", time.Since(start))
+	return "osascript:success", nil
 }
 
-// FocusGhosttyTab is the public entry point. Called by model.go.
-func FocusGhosttyTab(tty string, cwd string) error {
+// FocusGhosttyTab is the public entry point, called by ghosttyFocuser.Focus.
+func FocusGhosttyTab(tty string, cwd string) (string, error) {
 	return focusGhosttyTab(realDeps(), tty, cwd)
 }
 
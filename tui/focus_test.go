@@ -26,7 +26,7 @@ func TestFocusGhosttyTab_MatchByPrefix(t *testing.T) {
 			return nil
 		},
 	}
-	err := focusGhosttyTab(deps, "ttys001", "/Users/filip/osc_project")
+	result, err := focusGhosttyTab(deps, "ttys001", "/Users/filip/osc_project")
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -36,6 +36,9 @@ func TestFocusGhosttyTab_MatchByPrefix(t *testing.T) {
 	if activateCalled {
 		t.Error("activateApp should NOT have been called on success")
 	}
+	if result != "osascript:success" {
+		t.Errorf("result = %q, want %q", result, "osascript:success")
+	}
 }
 
 func TestFocusGhosttyTab_MatchByFolder(t *testing.T) {
@@ -47,13 +50,16 @@ func TestFocusGhosttyTab_MatchByFolder(t *testing.T) {
 		},
 		activateApp: func() error { return nil },
 	}
-	err := focusGhosttyTab(deps, "", "/home/user/my-folder")
+	result, err := focusGhosttyTab(deps, "", "/home/user/my-folder")
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 	if clicked != "my-folder" {
 		t.Errorf("want focusTab(%q), got focusTab(%q)", "my-folder", clicked)
 	}
+	if result != "osascript:success" {
+		t.Errorf("result = %q, want %q", result, "osascript:success")
+	}
 }
 
 func TestFocusGhosttyTab_NoMatch(t *testing.T) {
@@ -67,13 +73,16 @@ func TestFocusGhosttyTab_NoMatch(t *testing.T) {
 			return nil
 		},
 	}
-	err := focusGhosttyTab(deps, "", "/home/user/unknown")
+	result, err := focusGhosttyTab(deps, "", "/home/user/unknown")
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 	if !activateCalled {
 		t.Error("activateApp should have been called when no match")
 	}
+	if result != "osascript:fallback" {
+		t.Errorf("result = %q, want %q, so callers can tell this was a soft miss", result, "osascript:fallback")
+	}
 }
 
 func TestFocusGhosttyTab_GetMenuError(t *testing.T) {
@@ -87,13 +96,16 @@ func TestFocusGhosttyTab_GetMenuError(t *testing.T) {
 			return nil
 		},
 	}
-	err := focusGhosttyTab(deps, "", "/home/user/project")
+	result, err := focusGhosttyTab(deps, "", "/home/user/project")
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 	if !activateCalled {
 		t.Error("activateApp should have been called on focusTab error")
 	}
+	if result != "osascript:fallback" {
+		t.Errorf("result = %q, want %q", result, "osascript:fallback")
+	}
 }
 
 func TestFocusGhosttyTab_ClickFails(t *testing.T) {
@@ -107,13 +119,16 @@ func TestFocusGhosttyTab_ClickFails(t *testing.T) {
 			return nil
 		},
 	}
-	err := focusGhosttyTab(deps, "", "/home/user/osc_project")
+	result, err := focusGhosttyTab(deps, "", "/home/user/osc_project")
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 	if !activateCalled {
 		t.Error("activateApp should have been called when focusTab fails")
 	}
+	if result != "osascript:fallback" {
+		t.Errorf("result = %q, want %q", result, "osascript:fallback")
+	}
 }
 
 func TestFocusGhosttyTab_WritesTitleBeforeMenuLookup(t *testing.T) {
@@ -135,7 +150,7 @@ func TestFocusGhosttyTab_WritesTitleBeforeMenuLookup(t *testing.T) {
 		},
 		activateApp: func() error { return nil },
 	}
-	_ = focusGhosttyTab(deps, "ttys005", "/Users/filip/myproject")
+	_, _ = focusGhosttyTab(deps, "ttys005", "/Users/filip/myproject")
 	if len(callOrder) < 2 || callOrder[0] != "write" || callOrder[1] != "focus" {
 		t.Errorf("expected [write focus], got %v", callOrder)
 	}
@@ -155,7 +170,7 @@ func TestFocusGhosttyTab_EmptyTTYSkipsWrite(t *testing.T) {
 		focusTab:    func(cwdBasename string) error { return nil },
 		activateApp: func() error { return nil },
 	}
-	_ = focusGhosttyTab(deps, "", "/Users/filip/myproject")
+	_, _ = focusGhosttyTab(deps, "", "/Users/filip/myproject")
 	if writeCalled {
 		t.Error("writeTitle should NOT be called when tty is empty")
 	}
@@ -174,7 +189,7 @@ func TestFocusGhosttyTab_WriteTitleFailsStillMatches(t *testing.T) {
 		},
 		activateApp: func() error { return nil },
 	}
-	_ = focusGhosttyTab(deps, "ttys005", "/Users/filip/myproject")
+	_, _ = focusGhosttyTab(deps, "ttys005", "/Users/filip/myproject")
 	if clicked != "myproject" {
 		t.Errorf("should still focus even after writeTitle error, got %q", clicked)
 	}
@@ -270,7 +285,7 @@ func TestFocusGhosttyTab_Strategy1UsedFirst(t *testing.T) {
 		activateApp:   func() error { return nil },
 		waitAfterWrite: 0,
 	}
-	err := focusGhosttyTab(deps, "ttys005", "/Users/filip/myproject")
+	result, err := focusGhosttyTab(deps, "ttys005", "/Users/filip/myproject")
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -283,6 +298,9 @@ func TestFocusGhosttyTab_Strategy1UsedFirst(t *testing.T) {
 	if focusTabCalled {
 		t.Error("focusTab should NOT be called when Strategy 1 succeeds")
 	}
+	if result != "keystroke:success" {
+		t.Errorf("result = %q, want %q", result, "keystroke:success")
+	}
 }
 
 func TestFocusGhosttyTab_Strategy1FallsBackToStrategy2(t *testing.T) {
@@ -298,7 +316,7 @@ func TestFocusGhosttyTab_Strategy1FallsBackToStrategy2(t *testing.T) {
 		activateApp:   func() error { return nil },
 		waitAfterWrite: 0,
 	}
-	err := focusGhosttyTab(deps, "ttys005", "/Users/filip/myproject")
+	result, err := focusGhosttyTab(deps, "ttys005", "/Users/filip/myproject")
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -308,6 +326,9 @@ func TestFocusGhosttyTab_Strategy1FallsBackToStrategy2(t *testing.T) {
 	if !focusTabCalled {
 		t.Error("focusTab should be called in Strategy 2 fallback")
 	}
+	if result != "osascript:success" {
+		t.Errorf("result = %q, want %q", result, "osascript:success")
+	}
 }
 
 func TestFocusGhosttyTab_Strategy1FailsFallsBack(t *testing.T) {
@@ -323,7 +344,7 @@ func TestFocusGhosttyTab_Strategy1FailsFallsBack(t *testing.T) {
 		activateApp:   func() error { return nil },
 		waitAfterWrite: 0,
 	}
-	err := focusGhosttyTab(deps, "ttys005", "/Users/filip/myproject")
+	result, err := focusGhosttyTab(deps, "ttys005", "/Users/filip/myproject")
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -333,6 +354,9 @@ func TestFocusGhosttyTab_Strategy1FailsFallsBack(t *testing.T) {
 	if !focusTabCalled {
 		t.Error("focusTab should be called when Strategy 1 fails and falls back")
 	}
+	if result != "osascript:success" {
+		t.Errorf("result = %q, want %q", result, "osascript:success")
+	}
 }
 
 // ---------------------------------------------------------------------------
@@ -3,25 +3,104 @@ package main
 import (
 	"fmt"
 	"os"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+)
+
+var (
+	flagConfigPath    string
+	flagSessionsDirs  []string
+	flagIdleThreshold time.Duration
+	flagWatchDebounce time.Duration
+	flagThemeLight    bool
+	flagQuiet         bool
+	flagMetricsAddr   string
+	flagStalePoll     time.Duration
+	flagEventSinks    []string
 )
 
 func main() {
-	sessionsDir := os.ExpandEnv("$HOME/.claude/monitor/sessions")
+	root := &cobra.Command{
+		Use:   "claude-monitor",
+		Short: "Monitor Claude Code sessions in a terminal UI",
+		RunE:  runTUI,
+	}
+	root.PersistentFlags().StringVar(&flagConfigPath, "config", "", "path to config.yaml (default: $XDG_CONFIG_HOME/claude-monitor/config.yaml)")
+	root.PersistentFlags().StringSliceVar(&flagSessionsDirs, "sessions-dir", nil, "directory to watch for session JSON files (repeatable)")
+	root.PersistentFlags().DurationVar(&flagIdleThreshold, "idle-threshold", 0, "time since last activity before a session is idle (0 = use config/default)")
+	root.PersistentFlags().DurationVar(&flagWatchDebounce, "watch-debounce", 0, "debounce window for file watch events (0 = use config/default)")
+	root.PersistentFlags().BoolVar(&flagThemeLight, "light", false, "use the light-mode color palette")
+	root.PersistentFlags().BoolVar(&flagQuiet, "quiet", false, "suppress desktop notifications")
+	root.PersistentFlags().StringVar(&flagMetricsAddr, "metrics-addr", "", "if set, serve Prometheus metrics on this address (e.g. :9090)")
+	root.PersistentFlags().DurationVar(&flagStalePoll, "stale-poll-interval", 0, "periodic fallback scan interval for time-based status changes (0 = use config/default)")
+	root.PersistentFlags().StringSliceVar(&flagEventSinks, "event-sink", nil, `CloudEvents sink, repeatable: "stdout", "unix:<path>", or an http(s) webhook URL`)
+
+	root.AddCommand(newListCmd(), newJSONCmd(), newDismissCmd(), newFocusCmd(), newWatchCmd())
+
+	if err := root.Execute(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// loadEffectiveConfig merges CLI flags over the config file over
+// DefaultConfig().
+func loadEffectiveConfig(cmd *cobra.Command) Config {
+	path := flagConfigPath
+	if path == "" {
+		path = ConfigPath()
+	}
+	cfg, err := LoadConfig(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to load config %s: %v\n", path, err)
+	}
+
+	if len(flagSessionsDirs) > 0 {
+		cfg.SessionsDirs = flagSessionsDirs
+	}
+	if cmd.Flags().Changed("idle-threshold") {
+		cfg.IdleThreshold = flagIdleThreshold
+	}
+	if cmd.Flags().Changed("watch-debounce") {
+		cfg.WatchDebounce = flagWatchDebounce
+	}
+	if cmd.Flags().Changed("stale-poll-interval") {
+		cfg.StalePollInterval = flagStalePoll
+	}
+	if len(flagEventSinks) > 0 {
+		cfg.EventSinks = flagEventSinks
+	}
+	if cmd.Flags().Changed("light") {
+		cfg.Theme.Light = flagThemeLight
+	}
+	return cfg
+}
+
+// runTUI is the root command's default action: launch the interactive
+// monitor.
+func runTUI(cmd *cobra.Command, args []string) error {
+	cfg := loadEffectiveConfig(cmd)
+	applyConfig(cfg)
+
+	if flagMetricsAddr != "" {
+		StartMetricsServer(flagMetricsAddr)
+	}
+	if len(cfg.EventSinks) > 0 {
+		globalEventBus = NewEventBusFromSpecs(cfg.EventSinks)
+	}
 
 	// programRef is a shared pointer to the tea.Program, set before Run() is
 	// called. The watcher goroutine uses it to send sessionsChangedMsg.
 	programRef := &programRef{}
 
-	m := NewModel(sessionsDir, programRef)
+	m := NewModel(cfg.sessionsDirs(), cfg.KeyBindings, flagQuiet, programRef)
 	p := tea.NewProgram(m, tea.WithAltScreen())
 
 	// Set the reference so the watcher can send messages.
 	programRef.Set(p)
 
-	if _, err := p.Run(); err != nil {
-		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-		os.Exit(1)
-	}
+	_, err := p.Run()
+	return err
 }
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Metrics are only useful when --metrics-addr is set, but the collectors
+// themselves are cheap to register unconditionally so every code path (TUI,
+// CLI subcommands) can record through them without a nil check.
+var (
+	focusStrategyAttempts = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "focus_strategy_attempts_total",
+		Help: "Attempts to focus a session's terminal, by strategy and result.",
+	}, []string{"strategy", "result"})
+
+	focusLatency = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "focus_latency_seconds",
+		Help:    "Time taken to focus a session's terminal, by strategy.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"strategy"})
+
+	sessionStatusTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "session_status_total",
+		Help: "Count of sessions seen in full session scans, by status.",
+	}, []string{"status"})
+
+	sessionTransitionsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "session_transitions_total",
+		Help: "Count of observed session status transitions.",
+	}, []string{"from", "to"})
+)
+
+// observeFocusAttempt records the outcome and duration of one focus
+// strategy attempt inside focusGhosttyTab's Strategy 1 -> Strategy 2 ->
+// activate fallback chain.
+func observeFocusAttempt(strategy, result string, elapsed time.Duration) {
+	focusStrategyAttempts.WithLabelValues(strategy, result).Inc()
+	focusLatency.WithLabelValues(strategy).Observe(elapsed.Seconds())
+}
+
+// observeSessionStatus records one session's status as of a single full
+// scan (Notifier.snapshot, runWatch's report loop, the list command). It must not
+// be called from GetStatus itself, which runs once per render frame per
+// visible session and would otherwise make this counter track render
+// frequency rather than status occurrences.
+func observeSessionStatus(st Status) {
+	sessionStatusTotal.WithLabelValues(statusLabel(st)).Inc()
+}
+
+// observeSessionTransition records a from->to status change, as detected by
+// Notifier.Diff against its previous snapshot.
+func observeSessionTransition(from, to Status) {
+	sessionTransitionsTotal.WithLabelValues(statusLabel(from), statusLabel(to)).Inc()
+}
+
+// StartMetricsServer serves /metrics on addr in the background. Errors
+// (other than the server being closed) are logged rather than returned,
+// since a failed metrics exporter shouldn't take down the monitor.
+func StartMetricsServer(addr string) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	go func() {
+		if err := http.ListenAndServe(addr, mux); err != nil {
+			log.Printf("metrics server: %v", err)
+		}
+	}()
+}
@@ -4,10 +4,12 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"sort"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -61,72 +63,161 @@ var (
 	colorAccent = lipgloss.Color("#7D56F4")
 )
 
-// Styles.
+// Styles. Computed by initStyles from the current colors, rather than once
+// at package init, so applyTheme can rebuild them after overriding a color.
 var (
+	styleBorder               lipgloss.Style
+	styleTitle                lipgloss.Style
+	styleSessionTitle         lipgloss.Style
+	styleSessionTitleSelected lipgloss.Style
+	styleStatus               lipgloss.Style
+	styleMeta                 lipgloss.Style
+	styleSelected             lipgloss.Style
+	styleCursor               lipgloss.Style
+	styleFooter               lipgloss.Style
+	styleDotActive            lipgloss.Style
+	styleDotWaiting           lipgloss.Style
+	styleDotPermission        lipgloss.Style
+	styleDotIdle              lipgloss.Style
+	styleTranscript           lipgloss.Style
+	styleMatch                lipgloss.Style
+	colorSelectedBg           = lipgloss.Color("#1A1A2E")
+)
+
+func init() {
+	initStyles()
+}
+
+// initStyles (re)builds every style from the current color palette.
+func initStyles() {
 	styleBorder = lipgloss.NewStyle().
-			Border(lipgloss.RoundedBorder()).
-			BorderForeground(colorAccent).
-			Padding(0, 1)
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(colorAccent).
+		Padding(0, 1)
 
 	styleTitle = lipgloss.NewStyle().
-			Foreground(colorAccent).
-			Bold(true)
+		Foreground(colorAccent).
+		Bold(true)
 
 	styleSessionTitle = lipgloss.NewStyle().
-				Foreground(colorWhite).
-				Bold(true)
+		Foreground(colorWhite).
+		Bold(true)
 
 	styleSessionTitleSelected = lipgloss.NewStyle().
-					Foreground(colorAccent).
-					Bold(true)
+		Foreground(colorAccent).
+		Bold(true)
 
 	styleStatus = lipgloss.NewStyle().
-			Foreground(colorDim)
+		Foreground(colorDim)
 
 	styleMeta = lipgloss.NewStyle().
-			Foreground(colorDim)
+		Foreground(colorDim)
 
 	styleSelected = lipgloss.NewStyle().
-			Background(lipgloss.Color("#1A1A2E"))
+		Background(colorSelectedBg)
 
 	styleCursor = lipgloss.NewStyle().
-			Foreground(colorAccent).
-			Bold(true)
+		Foreground(colorAccent).
+		Bold(true)
 
 	styleFooter = lipgloss.NewStyle().
-			Foreground(colorDim)
+		Foreground(colorDim)
 
 	styleDotActive = lipgloss.NewStyle().
-			Foreground(colorOrange)
+		Foreground(colorOrange)
 
 	styleDotWaiting = lipgloss.NewStyle().
-			Foreground(colorGreen)
+		Foreground(colorGreen)
 
 	styleDotPermission = lipgloss.NewStyle().
-				Foreground(colorRed)
+		Foreground(colorRed)
 
 	styleDotIdle = lipgloss.NewStyle().
-			Foreground(colorGray)
-)
+		Foreground(colorGray)
+
+	styleTranscript = lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(colorAccent).
+		Padding(0, 1)
+
+	styleMatch = lipgloss.NewStyle().
+		Foreground(colorOrange).
+		Bold(true)
+}
+
+// applyTheme overrides the color palette from themeCfg and rebuilds the
+// styles derived from it. Light swaps in a light-background-friendly
+// baseline before any per-color overrides are applied on top.
+func applyTheme(themeCfg ThemeConfig) {
+	if themeCfg.Light {
+		colorWhite = lipgloss.Color("#1A1A1A")
+		colorDim = lipgloss.Color("#555555")
+		colorSelectedBg = lipgloss.Color("#E6E6F0")
+	}
+	if themeCfg.Orange != "" {
+		colorOrange = lipgloss.Color(themeCfg.Orange)
+	}
+	if themeCfg.Green != "" {
+		colorGreen = lipgloss.Color(themeCfg.Green)
+	}
+	if themeCfg.Red != "" {
+		colorRed = lipgloss.Color(themeCfg.Red)
+	}
+	if themeCfg.Gray != "" {
+		colorGray = lipgloss.Color(themeCfg.Gray)
+	}
+	if themeCfg.White != "" {
+		colorWhite = lipgloss.Color(themeCfg.White)
+	}
+	if themeCfg.Dim != "" {
+		colorDim = lipgloss.Color(themeCfg.Dim)
+	}
+	if themeCfg.Accent != "" {
+		colorAccent = lipgloss.Color(themeCfg.Accent)
+	}
+	initStyles()
+}
 
 // Model is the Bubble Tea model for the session monitor.
 type Model struct {
-	sessions    []Session
-	cursor      int
-	sessionsDir string
-	watcher     io.Closer
+	sessions     []Session
+	cursor       int
+	sessionsDirs []string
+	watcher      io.Closer
 	pRef        *programRef
 	width       int
 	height      int
+	keys        KeyMap
+	notifier    *Notifier
+
+	// filtering and filterQuery drive the "/" search mode: when filtering is
+	// true, keystrokes edit filterQuery instead of navigating the list.
+	filtering   bool
+	filterQuery string
+
+	// showTranscript opens a right-hand pane tailing the selected session's
+	// TranscriptPath in a scrollable viewport.
+	showTranscript    bool
+	transcriptSession string
+	transcriptPath    string
+	transcriptLines   []string
+	transcriptMtime   time.Time
+	viewport          viewport.Model
 }
 
-// NewModel creates a new Model with the given sessions directory and program reference.
-func NewModel(sessionsDir string, pRef *programRef) Model {
+// NewModel creates a new Model with the given sessions directories, key
+// bindings, notification setting and program reference.
+func NewModel(sessionsDirs []string, keys KeyMap, quiet bool, pRef *programRef) Model {
+	if keys == nil {
+		keys = DefaultKeyMap()
+	}
 	return Model{
-		sessionsDir: sessionsDir,
-		pRef:        pRef,
-		width:       80,
-		height:      24,
+		sessionsDirs: sessionsDirs,
+		keys:         keys,
+		notifier:     NewNotifier(quiet),
+		pRef:         pRef,
+		width:        80,
+		height:       24,
 	}
 }
 
@@ -140,9 +231,9 @@ func (m Model) Init() tea.Cmd {
 
 // cmdLoadSessions returns a Cmd that loads sessions from disk.
 func (m Model) cmdLoadSessions() tea.Cmd {
-	sessionsDir := m.sessionsDir
+	sessionsDirs := m.sessionsDirs
 	return func() tea.Msg {
-		sessions, _ := LoadSessions(sessionsDir)
+		sessions, _ := LoadSessions(sessionsDirs...)
 		return sessionsLoadedMsg{sessions: sessions}
 	}
 }
@@ -150,12 +241,12 @@ func (m Model) cmdLoadSessions() tea.Cmd {
 // cmdStartWatcher returns a Cmd that starts the file watcher.
 func (m Model) cmdStartWatcher() tea.Cmd {
 	pRef := m.pRef
-	sessionsDir := m.sessionsDir
+	sessionsDirs := m.sessionsDirs
 	return func() tea.Msg {
 		if pRef == nil {
 			return nil
 		}
-		watcher, err := WatchSessions(sessionsDir, func() {
+		watcher, err := WatchSessions(sessionsDirs, func() {
 			pRef.Send(sessionsChangedMsg{})
 		})
 		if err != nil {
@@ -171,11 +262,17 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
+		vw, vh := m.transcriptSize()
+		m.viewport.Width = vw
+		m.viewport.Height = vh
 		return m, nil
 
 	case sessionsLoadedMsg:
 		m.sessions = msg.sessions
 		m.clampCursor()
+		if m.notifier != nil {
+			m.notifier.Seed(m.sessions)
+		}
 		return m, nil
 
 	case watcherReadyMsg:
@@ -183,48 +280,126 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, nil
 
 	case sessionsChangedMsg:
-		sessions, err := LoadSessions(m.sessionsDir)
+		sessions, err := LoadSessions(m.sessionsDirs...)
 		if err == nil {
 			m.sessions = sessions
 			m.clampCursor()
+			if m.notifier != nil {
+				m.notifier.Diff(m.sessions)
+			}
+		}
+		if m.showTranscript && m.transcriptPath != "" {
+			return m, cmdLoadTranscript(m.transcriptSession, m.transcriptPath)
+		}
+		return m, nil
+
+	case transcriptLoadedMsg:
+		if msg.sessionID != m.transcriptSession || msg.err != nil {
+			return m, nil
 		}
+		m.transcriptMtime = msg.mtime
+		m.transcriptLines = splitTranscriptLines(msg.content)
+		m.viewport.SetContent(strings.Join(m.transcriptLines, "\n"))
 		return m, nil
 
+	case transcriptTickMsg:
+		if !m.showTranscript || m.transcriptPath == "" {
+			return m, nil
+		}
+		if mt := transcriptMtime(m.transcriptPath); mt.After(m.transcriptMtime) {
+			return m, tea.Batch(cmdLoadTranscript(m.transcriptSession, m.transcriptPath), cmdTranscriptTick())
+		}
+		return m, cmdTranscriptTick()
+
 	case tea.KeyMsg:
+		if m.filtering {
+			prevID := m.selectedSessionID()
+			switch msg.Type {
+			case tea.KeyEsc:
+				m.filtering = false
+				m.filterQuery = ""
+			case tea.KeyEnter:
+				m.filtering = false
+			case tea.KeyBackspace:
+				if r := []rune(m.filterQuery); len(r) > 0 {
+					m.filterQuery = string(r[:len(r)-1])
+				}
+			case tea.KeySpace:
+				m.filterQuery += " "
+			case tea.KeyRunes:
+				m.filterQuery += string(msg.Runes)
+			}
+			m.restoreCursorBySessionID(prevID)
+			return m, nil
+		}
+
+		if m.showTranscript {
+			switch msg.String() {
+			case "pgup", "pgdown", "home", "end":
+				var cmd tea.Cmd
+				m.viewport, cmd = m.viewport.Update(msg)
+				return m, cmd
+			case "y":
+				_ = copyTranscriptLine(m.viewport, m.transcriptLines)
+				return m, nil
+			}
+		}
+
 		switch msg.String() {
-		case "up", "j":
+		case "/":
+			m.filtering = true
+			return m, nil
+		case "v", "tab":
+			vis := m.visibleSessions()
+			if m.showTranscript {
+				m.showTranscript = false
+				return m, nil
+			}
+			if m.cursor < len(vis) {
+				return m, m.cmdOpenTranscript(vis[m.cursor])
+			}
+			return m, nil
+		}
+
+		switch m.keys.Action(msg.String()) {
+		case "up":
 			if m.cursor > 0 {
 				m.cursor--
 			}
-		case "down", "k":
-			if m.cursor < len(m.sessions)-1 {
+			if m.showTranscript {
+				return m, m.cmdSwitchTranscriptToCursor()
+			}
+		case "down":
+			if m.cursor < len(m.visibleSessions())-1 {
 				m.cursor++
 			}
-		case "enter", "f":
-			if m.cursor < len(m.sessions) {
-				s := m.sessions[m.cursor]
-				ghosttyTTY := s.GhosttyTTY
-				if ghosttyTTY == "" {
-					ghosttyTTY = s.TTY
-				}
+			if m.showTranscript {
+				return m, m.cmdSwitchTranscriptToCursor()
+			}
+		case "enter":
+			vis := m.visibleSessions()
+			if m.cursor < len(vis) {
+				s := vis[m.cursor]
 				return m, func() tea.Msg {
-					_ = FocusGhosttyTab(ghosttyTTY, s.CWD)
+					_ = FocusSession(s)
 					return nil
 				}
 			}
-		case "d":
-			if m.cursor < len(m.sessions) {
-				s := m.sessions[m.cursor]
+		case "dismiss":
+			vis := m.visibleSessions()
+			if m.cursor < len(vis) {
+				s := vis[m.cursor]
 				if s.GetStatus() == StatusIdle && s.FileName != "" {
 					_ = os.Remove(s.FileName)
-					sessions, err := LoadSessions(m.sessionsDir)
+					prevID := m.selectedSessionID()
+					sessions, err := LoadSessions(m.sessionsDirs...)
 					if err == nil {
 						m.sessions = sessions
-						m.clampCursor()
+						m.restoreCursorBySessionID(prevID)
 					}
 				}
 			}
-		case "q", "ctrl+c":
+		case "quit":
 			if m.watcher != nil {
 				_ = m.watcher.Close()
 			}
@@ -235,20 +410,115 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// transcriptSize returns the viewport dimensions for the right-hand pane,
+// accounting for the list pane and borders when split.
+func (m *Model) transcriptSize() (width, height int) {
+	innerWidth := m.width - 6
+	if innerWidth < 20 {
+		innerWidth = 20
+	}
+	width = innerWidth - innerWidth/2 - 2
+	if width < 10 {
+		width = 10
+	}
+	height = m.height - 8
+	if height < 5 {
+		height = 5
+	}
+	return width, height
+}
+
+// cmdOpenTranscript opens the transcript pane for s and starts tailing it.
+func (m *Model) cmdOpenTranscript(s Session) tea.Cmd {
+	m.showTranscript = true
+	m.transcriptSession = s.SessionID
+	m.transcriptPath = s.TranscriptPath
+	m.transcriptLines = nil
+	vw, vh := m.transcriptSize()
+	m.viewport = newTranscriptViewport(vw, vh)
+	if m.transcriptPath == "" {
+		return cmdTranscriptTick()
+	}
+	return tea.Batch(cmdLoadTranscript(m.transcriptSession, m.transcriptPath), cmdTranscriptTick())
+}
+
+// cmdSwitchTranscriptToCursor re-targets the open transcript pane at the
+// session now under the cursor.
+func (m *Model) cmdSwitchTranscriptToCursor() tea.Cmd {
+	vis := m.visibleSessions()
+	if m.cursor >= len(vis) {
+		return nil
+	}
+	return m.cmdOpenTranscript(vis[m.cursor])
+}
+
 // clampCursor ensures cursor is within valid range.
 func (m *Model) clampCursor() {
-	if len(m.sessions) == 0 {
+	n := len(m.visibleSessions())
+	if n == 0 {
 		m.cursor = 0
 		return
 	}
-	if m.cursor >= len(m.sessions) {
-		m.cursor = len(m.sessions) - 1
+	if m.cursor >= n {
+		m.cursor = n - 1
 	}
 	if m.cursor < 0 {
 		m.cursor = 0
 	}
 }
 
+// visibleSessions returns the sessions that should be rendered: all of them
+// when no filter is active, or the fuzzy matches against filterQuery sorted
+// by descending score otherwise.
+func (m *Model) visibleSessions() []Session {
+	if m.filterQuery == "" {
+		return m.sessions
+	}
+
+	type scored struct {
+		session Session
+		score   int
+	}
+	var matches []scored
+	for _, s := range m.sessions {
+		score, _, ok := sessionFuzzyScore(s, m.filterQuery)
+		if !ok {
+			continue
+		}
+		matches = append(matches, scored{session: s, score: score})
+	}
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].score > matches[j].score })
+
+	visible := make([]Session, len(matches))
+	for i, sc := range matches {
+		visible[i] = sc.session
+	}
+	return visible
+}
+
+// selectedSessionID returns the SessionID under the cursor, or "" if none.
+func (m *Model) selectedSessionID() string {
+	vis := m.visibleSessions()
+	if m.cursor >= 0 && m.cursor < len(vis) {
+		return vis[m.cursor].SessionID
+	}
+	return ""
+}
+
+// restoreCursorBySessionID moves the cursor back onto id within the current
+// visible list, falling back to clampCursor if id is no longer visible.
+func (m *Model) restoreCursorBySessionID(id string) {
+	if id != "" {
+		for i, s := range m.visibleSessions() {
+			if s.SessionID == id {
+				m.cursor = i
+				return
+			}
+		}
+	}
+	m.clampCursor()
+}
+
 // View renders the TUI.
 func (m Model) View() string {
 	// Calculate inner width (account for border + padding).
@@ -256,30 +526,51 @@ func (m Model) View() string {
 	if innerWidth < 20 {
 		innerWidth = 20
 	}
+	if m.showTranscript {
+		innerWidth -= innerWidth / 2
+	}
+
+	vis := m.visibleSessions()
 
 	var sb strings.Builder
 
 	// Build session rows.
-	for i, s := range m.sessions {
+	for i, s := range vis {
 		selected := i == m.cursor
-		sb.WriteString(renderSession(s, selected, innerWidth))
-		if i < len(m.sessions)-1 {
+		_, positions, _ := sessionFuzzyScore(s, m.filterQuery)
+		sb.WriteString(renderSession(s, selected, innerWidth, positions))
+		if i < len(vis)-1 {
 			sb.WriteString("\n")
 		}
 	}
 
-	if len(m.sessions) == 0 {
-		sb.WriteString(styleStatus.Render("No sessions found."))
+	if len(vis) == 0 {
+		if m.filterQuery != "" {
+			sb.WriteString(styleStatus.Render("No sessions match."))
+		} else {
+			sb.WriteString(styleStatus.Render("No sessions found."))
+		}
 	}
 
 	// Footer.
-	footer := styleFooter.Render("↑/j ↓/k Navigate · Enter/f Focus · d Dismiss · q Quit")
+	var footer string
+	switch {
+	case m.filtering:
+		footer = styleFooter.Render("Esc Cancel · Enter Apply filter")
+	case m.showTranscript:
+		footer = styleFooter.Render("↑/j ↓/k Switch · PgUp/PgDn/Home/End Scroll · y Copy top line · v/Tab Close")
+	default:
+		footer = styleFooter.Render("↑/j ↓/k Navigate · Enter/f Focus · d Dismiss · / Search · v Transcript · q Quit")
+	}
 
 	// Title with session count.
-	count := fmt.Sprintf("%d session", len(m.sessions))
-	if len(m.sessions) != 1 {
+	count := fmt.Sprintf("%d session", len(vis))
+	if len(vis) != 1 {
 		count += "s"
 	}
+	if m.filterQuery != "" {
+		count = fmt.Sprintf("%s/%d", count, len(m.sessions))
+	}
 	titleLeft := styleTitle.Render("Claude Monitor")
 	titleRight := styleTitle.Render(count)
 
@@ -290,17 +581,35 @@ func (m Model) View() string {
 	}
 	titleLine := titleLeft + strings.Repeat(" ", titlePadding) + titleRight
 
-	body := titleLine + "\n\n" + sb.String() + "\n\n" + footer
+	body := titleLine + "\n\n"
+	if m.filtering || m.filterQuery != "" {
+		body += renderSearchBar(m.filterQuery, m.filtering, innerWidth) + "\n\n"
+	}
+	body += sb.String() + "\n\n" + footer
 
 	box := styleBorder.
 		Width(innerWidth).
 		Render(body)
 
-	return box
+	if !m.showTranscript {
+		return box
+	}
+
+	title := "Transcript"
+	if m.cursor < len(vis) {
+		title = vis[m.cursor].Title()
+	}
+	transcript := lipgloss.JoinVertical(lipgloss.Left,
+		styleTitle.Render(title),
+		m.viewport.View(),
+	)
+	return lipgloss.JoinHorizontal(lipgloss.Top, box, " ", transcript)
 }
 
-// renderSession renders a single session as 3 lines.
-func renderSession(s Session, selected bool, width int) string {
+// renderSession renders a single session as 3 lines. matchPositions are rune
+// indices into s.Title() to highlight (from an active search filter); nil
+// renders the title unhighlighted.
+func renderSession(s Session, selected bool, width int, matchPositions []int) string {
 	status := s.GetStatus()
 
 	// Dot indicator.
@@ -334,7 +643,7 @@ func renderSession(s Session, selected bool, width int) string {
 	} else {
 		titleStyle = styleSessionTitle
 	}
-	title := cursor + dot + " " + titleStyle.Render(s.Title())
+	title := cursor + dot + " " + highlightTitle(s.Title(), matchPositions, titleStyle)
 
 	// Status + agents line.
 	agentTypes := s.ActiveAgentTypes()
@@ -384,6 +693,43 @@ func timeAgo(t time.Time) string {
 	}
 }
 
+// highlightTitle renders title with the runes at matchPositions highlighted
+// via styleMatch, and the rest rendered with base.
+func highlightTitle(title string, matchPositions []int, base lipgloss.Style) string {
+	if len(matchPositions) == 0 {
+		return base.Render(title)
+	}
+	matched := make(map[int]bool, len(matchPositions))
+	for _, p := range matchPositions {
+		matched[p] = true
+	}
+
+	r := []rune(title)
+	var sb strings.Builder
+	for i, ch := range r {
+		if matched[i] {
+			sb.WriteString(styleMatch.Render(string(ch)))
+		} else {
+			sb.WriteString(base.Render(string(ch)))
+		}
+	}
+	return sb.String()
+}
+
+// renderSearchBar renders the "/"-triggered filter input line.
+func renderSearchBar(query string, editing bool, width int) string {
+	cursor := ""
+	if editing {
+		cursor = styleCursor.Render("█")
+	}
+	bar := styleTitle.Render("/ ") + query + cursor
+	padding := width - lipgloss.Width(bar)
+	if padding < 0 {
+		padding = 0
+	}
+	return bar + strings.Repeat(" ", padding)
+}
+
 // clampMin returns a if a >= minVal, else minVal.
 func clampMin(minVal, a int) int {
 	if a < minVal {
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"fmt"
+	"os/exec"
+	"runtime"
+	"time"
+
+	"github.com/gen2brain/beeep"
+)
+
+// notifyMinInterval rate-limits notifications so a burst of transitions
+// (e.g. several agents completing at once) doesn't spam the user.
+const notifyMinInterval = 2 * time.Second
+
+// Notifier diffs consecutive LoadSessions snapshots and emits an OS
+// notification for the transitions worth interrupting the user for:
+// a session becoming StatusPermission or StatusWaiting, or an agent
+// finishing (status -> "completed").
+type Notifier struct {
+	quiet      bool
+	lastSent   time.Time
+	prevStatus map[string]Status
+	prevAgents map[string]map[string]string
+}
+
+// NewNotifier creates a Notifier. When quiet is true, Diff still tracks
+// state but never actually sends a notification.
+func NewNotifier(quiet bool) *Notifier {
+	return &Notifier{
+		quiet:      quiet,
+		prevStatus: map[string]Status{},
+		prevAgents: map[string]map[string]string{},
+	}
+}
+
+// Seed records sessions' current state without notifying, so the first real
+// Diff call doesn't treat every already-waiting session as a fresh
+// transition.
+func (n *Notifier) Seed(sessions []Session) {
+	n.prevStatus, n.prevAgents = n.snapshot(sessions)
+}
+
+// Diff compares sessions against the last snapshot (from Seed or a prior
+// Diff) and notifies for each new permission/waiting transition or
+// newly-completed agent.
+func (n *Notifier) Diff(sessions []Session) {
+	next, nextAgents := n.snapshot(sessions)
+
+	for _, s := range sessions {
+		st := next[s.SessionID]
+		if was, ok := n.prevStatus[s.SessionID]; ok && was != st {
+			observeSessionTransition(was, st)
+			globalEventBus.EmitSessionTransition(s, was, st)
+		}
+		if was, ok := n.prevStatus[s.SessionID]; (!ok || was != st) && (st == StatusPermission || st == StatusWaiting) {
+			n.notify(notificationTitle(st), s.Title())
+		}
+		for agentID, status := range nextAgents[s.SessionID] {
+			if status != "completed" {
+				continue
+			}
+			if n.prevAgents[s.SessionID][agentID] == "completed" {
+				continue
+			}
+			if a, ok := s.Agents[agentID]; ok {
+				n.notify("Agent completed", fmt.Sprintf("%s (%s)", a.AgentName, s.Title()))
+			}
+		}
+	}
+
+	n.prevStatus, n.prevAgents = next, nextAgents
+}
+
+// snapshot builds the per-session status and per-agent status maps used to
+// detect transitions.
+func (n *Notifier) snapshot(sessions []Session) (map[string]Status, map[string]map[string]string) {
+	status := make(map[string]Status, len(sessions))
+	agents := make(map[string]map[string]string, len(sessions))
+	for _, s := range sessions {
+		st := s.GetStatus()
+		status[s.SessionID] = st
+		observeSessionStatus(st)
+		agentStates := make(map[string]string, len(s.Agents))
+		for id, a := range s.Agents {
+			agentStates[id] = a.Status
+		}
+		agents[s.SessionID] = agentStates
+	}
+	return status, agents
+}
+
+// notificationTitle returns the notification title for a status transition.
+func notificationTitle(st Status) string {
+	switch st {
+	case StatusPermission:
+		return "Permission required"
+	case StatusWaiting:
+		return "Waiting for input"
+	default:
+		return "Claude Monitor"
+	}
+}
+
+// notify sends title/body through the platform backend, rate-limited to
+// notifyMinInterval and suppressed entirely when quiet.
+func (n *Notifier) notify(title, body string) {
+	if n.quiet {
+		return
+	}
+	if time.Since(n.lastSent) < notifyMinInterval {
+		return
+	}
+	n.lastSent = time.Now()
+	_ = sendNotification(title, body)
+}
+
+// scriptNotify shows a native notification via AppleScript. title/body are
+// passed as argv, not interpolated into the script, so session content
+// (e.g. a UserPrompt-derived title) can't break out of the string literal.
+const scriptNotify = `
+on run argv
+  set theTitle to item 1 of argv
+  set theBody to item 2 of argv
+  display notification theBody with title theTitle
+end run
+`
+
+// sendNotification dispatches to the platform-appropriate notifier: macOS
+// notification center via osascript, SnoreToast on Windows, and
+// beeep (falling back to notify-send) on Linux/BSD.
+func sendNotification(title, body string) error {
+	switch runtime.GOOS {
+	case "darwin":
+		_, err := runOsascript(scriptNotify, title, body)
+		return err
+	case "windows":
+		return exec.Command("SnoreToast", "-t", title, "-m", body).Run()
+	default:
+		if err := beeep.Notify(title, body, ""); err == nil {
+			return nil
+		}
+		return exec.Command("notify-send", title, body).Run()
+	}
+}
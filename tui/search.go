@@ -0,0 +1,67 @@
+package main
+
+import "strings"
+
+// fuzzyMatch scores how well pattern fuzzily matches target and returns the
+// rune positions in target that were matched, for highlighting. ok is false
+// if some rune of pattern could not be matched in order.
+//
+// The scoring is a simplified Smith-Waterman-style local alignment: matches
+// score a point each, and runs of consecutive matched runes score a bonus,
+// so "mon" ranks "monitor" above "m-o-n-itor".
+func fuzzyMatch(pattern, target string) (score int, positions []int, ok bool) {
+	if pattern == "" {
+		return 0, nil, true
+	}
+	p := []rune(strings.ToLower(pattern))
+	t := []rune(strings.ToLower(target))
+
+	pi := 0
+	prevMatch := -2
+	for ti := 0; ti < len(t) && pi < len(p); ti++ {
+		if t[ti] != p[pi] {
+			continue
+		}
+		positions = append(positions, ti)
+		score++
+		if ti == prevMatch+1 {
+			score += 5
+		}
+		prevMatch = ti
+		pi++
+	}
+	if pi < len(p) {
+		return 0, nil, false
+	}
+	return score, positions, true
+}
+
+// sessionFuzzyScore fuzzy-matches query against the session's Title, CWD,
+// UserPrompt, active agent types and TTY, returning the best score across
+// those fields and the matched rune positions within Title (used to
+// highlight the rendered title line). matched is false if query fails to
+// match every field.
+func sessionFuzzyScore(s Session, query string) (score int, titlePositions []int, matched bool) {
+	if query == "" {
+		return 0, nil, true
+	}
+
+	title := s.Title()
+	fields := append([]string{title, s.CWD, s.UserPrompt, s.TTY}, s.ActiveAgentTypes()...)
+
+	best := -1
+	for i, f := range fields {
+		sc, pos, ok := fuzzyMatch(query, f)
+		if !ok {
+			continue
+		}
+		matched = true
+		if sc > best {
+			best = sc
+		}
+		if i == 0 {
+			titlePositions = pos
+		}
+	}
+	return best, titlePositions, matched
+}
@@ -0,0 +1,100 @@
+//go:build !integration
+
+package main
+
+import "testing"
+
+func TestFuzzyMatch(t *testing.T) {
+	cases := []struct {
+		name    string
+		pattern string
+		target  string
+		wantOK  bool
+		wantPos []int
+	}{
+		{"empty pattern matches anything", "", "anything", true, nil},
+		{"simple match", "cm", "claude-monitor", true, []int{0, 7}},
+		{"scattered match", "cm", "codemonitor", true, []int{0, 4}},
+		{"no match", "xyz", "claude-monitor", false, nil},
+		{"case insensitive", "CM", "claude-monitor", true, []int{0, 7}},
+		{"out of order fails", "mc", "claude", false, nil},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			_, pos, ok := fuzzyMatch(c.pattern, c.target)
+			if ok != c.wantOK {
+				t.Fatalf("fuzzyMatch(%q, %q) ok = %v, want %v", c.pattern, c.target, ok, c.wantOK)
+			}
+			if !ok {
+				return
+			}
+			if len(pos) != len(c.wantPos) {
+				t.Fatalf("fuzzyMatch(%q, %q) positions = %v, want %v", c.pattern, c.target, pos, c.wantPos)
+			}
+			for i := range pos {
+				if pos[i] != c.wantPos[i] {
+					t.Errorf("fuzzyMatch(%q, %q) positions = %v, want %v", c.pattern, c.target, pos, c.wantPos)
+					break
+				}
+			}
+		})
+	}
+}
+
+// TestFuzzyMatch_ConsecutiveRunRanksHigher pins the bonus described in
+// fuzzyMatch's doc comment: a pattern matched as one consecutive run scores
+// higher than the same pattern matched via scattered runes, so e.g. "mon"
+// ranks "monitor" above "m-o-n-itor".
+func TestFuzzyMatch_ConsecutiveRunRanksHigher(t *testing.T) {
+	consecutive, _, ok := fuzzyMatch("mon", "monitor")
+	if !ok {
+		t.Fatal("expected match against monitor")
+	}
+	scattered, _, ok := fuzzyMatch("mon", "m-o-n-itor")
+	if !ok {
+		t.Fatal("expected match against m-o-n-itor")
+	}
+	if consecutive <= scattered {
+		t.Errorf("consecutive match score %d should rank above scattered match score %d", consecutive, scattered)
+	}
+}
+
+func TestSessionFuzzyScore(t *testing.T) {
+	s := Session{
+		CWD:        "/Users/filip/claude-monitor",
+		UserPrompt: "fix the flaky test",
+		TTY:        "ttys001",
+	}
+
+	t.Run("empty query matches", func(t *testing.T) {
+		_, _, matched := sessionFuzzyScore(s, "")
+		if !matched {
+			t.Error("empty query should match")
+		}
+	})
+
+	t.Run("matches non-title field", func(t *testing.T) {
+		_, _, matched := sessionFuzzyScore(s, "flaky")
+		if !matched {
+			t.Error("expected match against UserPrompt")
+		}
+	})
+
+	t.Run("no match across any field", func(t *testing.T) {
+		_, _, matched := sessionFuzzyScore(s, "zzz_no_such_thing")
+		if matched {
+			t.Error("expected no match")
+		}
+	})
+
+	t.Run("title positions reported", func(t *testing.T) {
+		titled := Session{UserPrompt: "claude-monitor work"}
+		_, pos, matched := sessionFuzzyScore(titled, "claude")
+		if !matched {
+			t.Fatal("expected match")
+		}
+		if len(pos) == 0 {
+			t.Error("expected non-empty title match positions")
+		}
+	})
+}
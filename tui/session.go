@@ -3,6 +3,7 @@ package main
 import (
 	"encoding/json"
 	"io"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"sort"
@@ -43,10 +44,32 @@ type Session struct {
 	GhosttyTTY       string             `json:"ghostty_tty"`
 	Agents           map[string]Agent   `json:"agents"`
 
+	// KittyWindowID and TmuxPane are populated by the hook writer when the
+	// session originates inside Kitty or a tmux pane, letting the matching
+	// terminalFocuser target the window/pane directly instead of falling
+	// back to a TTY or cwd search.
+	KittyWindowID string `json:"kitty_window_id"`
+	TmuxPane      string `json:"tmux_pane"`
+
 	// FileName is set to the JSON file path for deletion support.
 	FileName string `json:"-"`
 }
 
+// idleThreshold is how long a session can go without activity before
+// GetStatus reports it as idle. Overridable via Config.IdleThreshold.
+var idleThreshold = 30 * time.Second
+
+// watchDebounce is how long WatchSessions waits after the last fsnotify
+// event before calling onChange. Overridable via Config.WatchDebounce.
+var watchDebounce = 300 * time.Millisecond
+
+// watchStalePollInterval is how often WatchSessions calls onChange on a
+// timer, independent of fsnotify events. fsnotify only fires on file
+// activity, so it never observes a session going active->idle purely
+// because idleThreshold elapsed; this periodic fallback is what catches
+// that transition (see TestGetStatus_StaleActiveBecomesIdle).
+var watchStalePollInterval = 30 * time.Second
+
 // Status returns the display status of the session.
 type Status int
 
@@ -57,14 +80,17 @@ const (
 	StatusIdle
 )
 
-// GetStatus derives the status from session fields.
+// GetStatus derives the status from session fields. Called once per render
+// frame per visible session, so it does not record metrics itself — callers
+// that scan a full session set once (Notifier.snapshot, runWatch's report
+// loop) record session_status_total instead.
 func (s *Session) GetStatus() Status {
 	if s.IsPermission {
 		return StatusPermission
 	}
 	switch s.HookEventName {
 	case "PreToolUse", "PostToolUse", "UserPromptSubmit":
-		if time.Since(s.Time()) > 30*time.Second {
+		if time.Since(s.Time()) > idleThreshold {
 			return StatusIdle
 		}
 		return StatusActive
@@ -122,28 +148,39 @@ func (s *Session) Time() time.Time {
 	return time.Unix(sec, nsec)
 }
 
-// LoadSessions reads all *.json files from dir and returns parsed sessions.
-func LoadSessions(dir string) ([]Session, error) {
-	entries, err := filepath.Glob(filepath.Join(dir, "*.json"))
-	if err != nil {
-		return nil, err
-	}
-
+// LoadSessions reads all *.json files from dirs, recursing into
+// subdirectories, and returns parsed sessions sorted by timestamp
+// descending. A single dir (the common case) is just a one-element call.
+func LoadSessions(dirs ...string) ([]Session, error) {
 	var sessions []Session
-	for _, path := range entries {
-		data, err := os.ReadFile(path)
+	for _, dir := range dirs {
+		err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				if os.IsNotExist(err) {
+					return nil
+				}
+				return err
+			}
+			if d.IsDir() || filepath.Ext(path) != ".json" {
+				return nil
+			}
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return nil
+			}
+			var s Session
+			if err := json.Unmarshal(data, &s); err != nil {
+				return nil
+			}
+			s.FileName = path
+			sessions = append(sessions, s)
+			return nil
+		})
 		if err != nil {
-			continue
-		}
-		var s Session
-		if err := json.Unmarshal(data, &s); err != nil {
-			continue
+			return nil, err
 		}
-		s.FileName = path
-		sessions = append(sessions, s)
 	}
 
-	// Sort by timestamp descending.
 	sort.Slice(sessions, func(i, j int) bool {
 		return sessions[i].Timestamp > sessions[j].Timestamp
 	})
@@ -162,17 +199,44 @@ func (w *watchCloser) Close() error {
 	return w.watcher.Close()
 }
 
-// WatchSessions watches dir for file changes and calls onChange (debounced ~300ms).
-// Returns an io.Closer to stop watching.
-func WatchSessions(dir string, onChange func()) (io.Closer, error) {
+// addWatchesRecursive adds an fsnotify watch on dir and every subdirectory
+// beneath it, so sessions staged in per-project subdirectories are seen too.
+func addWatchesRecursive(watcher *fsnotify.Watcher, dir string) error {
+	return filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() {
+			return watcher.Add(path)
+		}
+		return nil
+	})
+}
+
+// WatchSessions watches dirs, and all their subdirectories recursively, for
+// session file changes. Each changed file gets its own debounce timer
+// (~300ms, via watchDebounce), so a rapid rewrite of one file doesn't delay
+// onChange for a change to another. A subdirectory created after the watch
+// starts is picked up on the fly. Rename events are debounced too, so
+// editors that atomically write foo.json.tmp then rename it over foo.json
+// still trigger onChange. A watchStalePollInterval ticker also calls
+// onChange regardless of fsnotify activity, so purely time-based status
+// changes (active->idle) still get picked up. Returns an io.Closer to stop
+// watching.
+func WatchSessions(dirs []string, onChange func()) (io.Closer, error) {
 	watcher, err := fsnotify.NewWatcher()
 	if err != nil {
 		return nil, err
 	}
 
-	if err := watcher.Add(dir); err != nil {
-		watcher.Close()
-		return nil, err
+	for _, dir := range dirs {
+		if err := addWatchesRecursive(watcher, dir); err != nil {
+			watcher.Close()
+			return nil, err
+		}
 	}
 
 	wc := &watchCloser{
@@ -182,24 +246,48 @@ func WatchSessions(dir string, onChange func()) (io.Closer, error) {
 
 	go func() {
 		var mu sync.Mutex
-		var timer *time.Timer
+		timers := make(map[string]*time.Timer)
+
+		// callMu serializes onChange itself: per-file debounce timers each
+		// fire on their own goroutine, so without this a burst touching two
+		// files would invoke onChange concurrently. Callers like runWatch
+		// keep non-thread-safe state (a plain map) across calls, so
+		// onChange must never run two-at-once.
+		var callMu sync.Mutex
+		serializedOnChange := func() {
+			callMu.Lock()
+			defer callMu.Unlock()
+			onChange()
+		}
+
+		ticker := time.NewTicker(watchStalePollInterval)
+		defer ticker.Stop()
 
 		for {
 			select {
 			case <-wc.done:
 				return
+			case <-ticker.C:
+				serializedOnChange()
 			case event, ok := <-watcher.Events:
 				if !ok {
 					return
 				}
-				if event.Has(fsnotify.Write) || event.Has(fsnotify.Create) || event.Has(fsnotify.Remove) {
-					mu.Lock()
-					if timer != nil {
-						timer.Stop()
+				if event.Has(fsnotify.Create) {
+					if info, err := os.Stat(event.Name); err == nil && info.IsDir() {
+						_ = addWatchesRecursive(watcher, event.Name)
 					}
-					timer = time.AfterFunc(300*time.Millisecond, onChange)
-					mu.Unlock()
 				}
+				if !event.Has(fsnotify.Write) && !event.Has(fsnotify.Create) &&
+					!event.Has(fsnotify.Remove) && !event.Has(fsnotify.Rename) {
+					continue
+				}
+				mu.Lock()
+				if t, ok := timers[event.Name]; ok {
+					t.Stop()
+				}
+				timers[event.Name] = time.AfterFunc(watchDebounce, serializedOnChange)
+				mu.Unlock()
 			case _, ok := <-watcher.Errors:
 				if !ok {
 					return
@@ -0,0 +1,395 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// terminalFocuser is implemented by each supported terminal emulator so the
+// monitor can bring the right tab/pane/window to the front without being
+// hard-coded to Ghostty on macOS.
+type terminalFocuser interface {
+	// Focus switches to the tab/pane/window that owns s. Implementations
+	// should do their best and return a nil error even on a soft miss
+	// (e.g. no matching tab found), mirroring FocusGhosttyTab's fallback
+	// behaviour. result is a short machine-readable outcome (e.g.
+	// "success", "osascript:fallback") reported verbatim in the
+	// dev.claude.focus.attempted CloudEvent. Only the Ghostty backend
+	// reports a soft miss in result; the other built-in backends report
+	// "success" whenever err is nil, including when nothing matched.
+	Focus(s Session) (result string, err error)
+}
+
+// focusResult derives the (result, err) pair Focus returns for backends
+// whose outcome is a plain success/error binary, as opposed to Ghostty's
+// multi-strategy result.
+func focusResult(err error) (string, error) {
+	if err != nil {
+		return "error", err
+	}
+	return "success", nil
+}
+
+// terminalEnvOverride is the env var that forces a specific backend,
+// bypassing auto-detection. Valid values are terminalRegistry's keys.
+const terminalEnvOverride = "CLAUDE_MONITOR_TERMINAL"
+
+// terminalRegistry maps a backend name to its constructor. Built-in backends
+// register themselves in init(); third parties can add their own via
+// RegisterTerminalBackend without touching this file or main.
+var terminalRegistry = map[string]func() terminalFocuser{}
+
+func init() {
+	RegisterTerminalBackend("ghostty", func() terminalFocuser { return ghosttyFocuser{} })
+	RegisterTerminalBackend("iterm2", func() terminalFocuser { return iTerm2Focuser{} })
+	RegisterTerminalBackend("appleterminal", func() terminalFocuser { return appleTerminalFocuser{} })
+	RegisterTerminalBackend("kitty", func() terminalFocuser { return kittyFocuser{} })
+	RegisterTerminalBackend("wezterm", func() terminalFocuser { return wezTermFocuser{} })
+	RegisterTerminalBackend("tmux", func() terminalFocuser { return tmuxFocuser{} })
+	RegisterTerminalBackend("x11", func() terminalFocuser { return x11Focuser{} })
+	RegisterTerminalBackend("wayland", func() terminalFocuser { return waylandFocuser{} })
+}
+
+// RegisterTerminalBackend adds (or replaces) a named terminalFocuser
+// constructor, so a third party can support another terminal emulator by
+// calling this from their own init() rather than editing this file.
+func RegisterTerminalBackend(name string, factory func() terminalFocuser) {
+	terminalRegistry[name] = factory
+}
+
+// ancestorProcessNames returns the comm name of pid and each of its
+// ancestors up to pid 1, nearest first, by repeatedly shelling out to ps.
+// Used to detect which terminal emulator owns the current TTY, the same way
+// findGhosttyTabIndexFromPS walks ps output to find a child of Ghostty.
+func ancestorProcessNames(pid int) []string {
+	var names []string
+	for pid > 1 && len(names) < 32 {
+		out, err := exec.Command("ps", "-o", "ppid=,comm=", "-p", strconv.Itoa(pid)).Output()
+		if err != nil {
+			break
+		}
+		fields := strings.Fields(string(out))
+		if len(fields) < 2 {
+			break
+		}
+		ppid, err := strconv.Atoi(fields[0])
+		if err != nil {
+			break
+		}
+		names = append(names, fields[1])
+		pid = ppid
+	}
+	return names
+}
+
+// terminalNamesByProcess maps a known terminal emulator's ps comm name to
+// its terminalRegistry key.
+var terminalNamesByProcess = map[string]string{
+	"Ghostty":     "ghostty",
+	"ghostty":     "ghostty",
+	"iTerm2":      "iterm2",
+	"Terminal":    "appleterminal",
+	"wezterm-gui": "wezterm",
+	"kitty":       "kitty",
+}
+
+// detectTerminalFromAncestors walks the monitor process's own ancestors
+// looking for a known terminal emulator and returns its terminalRegistry
+// key. It identifies the terminal the monitor itself runs in; the session's
+// TTY is not examined, so a session started in a different terminal app is
+// focused through the monitor's terminal backend. Returns "" if none of the
+// ancestors are recognised.
+func detectTerminalFromAncestors() string {
+	for _, name := range ancestorProcessNames(os.Getpid()) {
+		// macOS ps reports a GUI app's comm as its full executable path.
+		if key, ok := terminalNamesByProcess[filepath.Base(name)]; ok {
+			return key
+		}
+	}
+	return ""
+}
+
+// detectTerminalFocuser picks the terminalFocuser to use: an explicit
+// CLAUDE_MONITOR_TERMINAL override, then the terminal found by walking
+// process ancestry, then environment-variable heuristics ($KITTY_LISTEN_ON,
+// $TERM_PROGRAM, $TMUX, $WAYLAND_DISPLAY, $DISPLAY). tmux is checked ahead of
+// the surrounding GUI terminal since a tmux pane should be selected first
+// even when the outer terminal also matches.
+func detectTerminalFocuser() (name string, focuser terminalFocuser) {
+	if name := os.Getenv(terminalEnvOverride); name != "" {
+		if factory, ok := terminalRegistry[name]; ok {
+			return name, factory()
+		}
+	}
+
+	if os.Getenv("TMUX") != "" {
+		return "tmux", tmuxFocuser{}
+	}
+
+	if name := detectTerminalFromAncestors(); name != "" {
+		if factory, ok := terminalRegistry[name]; ok {
+			return name, factory()
+		}
+	}
+
+	if os.Getenv("KITTY_LISTEN_ON") != "" {
+		return "kitty", kittyFocuser{}
+	}
+	switch os.Getenv("TERM_PROGRAM") {
+	case "iTerm.app":
+		return "iterm2", iTerm2Focuser{}
+	case "WezTerm":
+		return "wezterm", wezTermFocuser{}
+	case "ghostty":
+		return "ghostty", ghosttyFocuser{}
+	case "Apple_Terminal":
+		return "appleterminal", appleTerminalFocuser{}
+	}
+	if os.Getenv("WAYLAND_DISPLAY") != "" {
+		return "wayland", waylandFocuser{}
+	}
+	if os.Getenv("DISPLAY") != "" {
+		return "x11", x11Focuser{}
+	}
+
+	// Fall back to the original Ghostty/macOS behaviour.
+	return "ghostty", ghosttyFocuser{}
+}
+
+// FocusSession focuses the terminal tab/pane/window that owns s, dispatching
+// to the backend detected for the current environment, and emits a
+// dev.claude.focus.attempted CloudEvent recording which backend was chosen
+// and whether it succeeded.
+func FocusSession(s Session) error {
+	name, focuser := detectTerminalFocuser()
+	result, err := focuser.Focus(s)
+	globalEventBus.EmitFocusAttempted(s.SessionID, name, result)
+	return err
+}
+
+// ghosttyFocuser wraps the existing Ghostty/macOS focus logic.
+type ghosttyFocuser struct{}
+
+func (ghosttyFocuser) Focus(s Session) (string, error) {
+	tty := s.GhosttyTTY
+	if tty == "" {
+		tty = s.TTY
+	}
+	return FocusGhosttyTab(tty, s.CWD)
+}
+
+// iTerm2Focuser focuses a tab/session in iTerm2 via AppleScript, matching on
+// the session's TTY since the hook writer does not carry an iTerm2 session
+// id.
+type iTerm2Focuser struct{}
+
+const scriptFocusITerm2 = `
+on run argv
+  set targetTTY to item 1 of argv
+  tell application "iTerm2"
+    activate
+    repeat with w in windows
+      repeat with t in tabs of w
+        repeat with s in sessions of t
+          if (tty of s) contains targetTTY then
+            select t
+            select s
+            return "found"
+          end if
+        end repeat
+      end repeat
+    end repeat
+  end tell
+  return "not_found"
+end run
+`
+
+func (iTerm2Focuser) Focus(s Session) (string, error) {
+	tty := s.TTY
+	if tty == "" {
+		return focusResult(nil)
+	}
+	_, err := runOsascript(scriptFocusITerm2, tty)
+	return focusResult(err)
+}
+
+// appleTerminalFocuser focuses a tab in macOS's built-in Terminal.app via
+// AppleScript, matching on the session's TTY.
+type appleTerminalFocuser struct{}
+
+const scriptFocusAppleTerminal = `
+on run argv
+  set targetTTY to item 1 of argv
+  tell application "Terminal"
+    activate
+    repeat with w in windows
+      repeat with t in tabs of w
+        if (tty of t) contains targetTTY then
+          set selected tab of w to t
+          set index of w to 1
+          return "found"
+        end if
+      end repeat
+    end repeat
+  end tell
+  return "not_found"
+end run
+`
+
+func (appleTerminalFocuser) Focus(s Session) (string, error) {
+	tty := s.TTY
+	if tty == "" {
+		return focusResult(nil)
+	}
+	_, err := runOsascript(scriptFocusAppleTerminal, tty)
+	return focusResult(err)
+}
+
+// kittyFocuser focuses a window via kitty's remote control protocol, using
+// KittyWindowID when the hook writer populated it, falling back to a cwd
+// match otherwise.
+type kittyFocuser struct{}
+
+func (kittyFocuser) Focus(s Session) (string, error) {
+	socket := os.Getenv("KITTY_LISTEN_ON")
+	if socket == "" {
+		return focusResult(fmt.Errorf("KITTY_LISTEN_ON not set"))
+	}
+
+	var match string
+	if s.KittyWindowID != "" {
+		match = "id:" + s.KittyWindowID
+	} else if s.CWD != "" {
+		match = "cwd:" + s.CWD
+	} else {
+		return focusResult(fmt.Errorf("no kitty window id or cwd to match"))
+	}
+
+	return focusResult(exec.Command("kitty", "@", "--to", socket, "focus-window", "--match", match).Run())
+}
+
+// wezTermFocuser focuses a pane via `wezterm cli activate-pane`, matched by
+// cwd through `wezterm cli list` since the hook writer does not (yet) carry
+// a WezTerm pane id.
+type wezTermFocuser struct{}
+
+func (wezTermFocuser) Focus(s Session) (string, error) {
+	paneID, err := wezTermPaneIDForCWD(s.CWD)
+	if err != nil || paneID == "" {
+		return focusResult(err)
+	}
+	return focusResult(exec.Command("wezterm", "cli", "activate-pane", "--pane-id", paneID).Run())
+}
+
+// wezTermPaneEntry is the subset of `wezterm cli list --format json`'s
+// per-pane fields this package needs. wezterm reports cwd as a file:// URI
+// (e.g. "file://hostname/Users/filip/project"), so matching is a substring
+// check against the path rather than an exact comparison.
+type wezTermPaneEntry struct {
+	PaneID int    `json:"pane_id"`
+	Cwd    string `json:"cwd"`
+}
+
+// wezTermPaneIDForCWD shells out to `wezterm cli list` and returns the id of
+// a pane whose cwd matches.
+func wezTermPaneIDForCWD(cwd string) (string, error) {
+	if cwd == "" {
+		return "", nil
+	}
+	out, err := exec.Command("wezterm", "cli", "list", "--format", "json").Output()
+	if err != nil {
+		return "", err
+	}
+	return wezTermPaneIDFromJSON(out, cwd)
+}
+
+// wezTermPaneIDFromJSON is the testable core of wezTermPaneIDForCWD: it
+// parses `wezterm cli list --format json`'s output (passed in for
+// testability) and returns the id of the first pane whose cwd matches.
+func wezTermPaneIDFromJSON(data []byte, cwd string) (string, error) {
+	var panes []wezTermPaneEntry
+	if err := json.Unmarshal(data, &panes); err != nil {
+		return "", err
+	}
+	for _, p := range panes {
+		if strings.Contains(p.Cwd, cwd) {
+			return strconv.Itoa(p.PaneID), nil
+		}
+	}
+	return "", nil
+}
+
+// tmuxFocuser focuses a tmux pane via `select-window`/`select-pane`, using
+// TmuxPane (e.g. "session:window.pane") when populated by the hook writer,
+// falling back to matching the pane's TTY.
+type tmuxFocuser struct{}
+
+func (tmuxFocuser) Focus(s Session) (string, error) {
+	if s.TmuxPane != "" {
+		if err := exec.Command("tmux", "select-window", "-t", s.TmuxPane).Run(); err != nil {
+			return focusResult(err)
+		}
+		return focusResult(exec.Command("tmux", "select-pane", "-t", s.TmuxPane).Run())
+	}
+	if s.TTY == "" {
+		return focusResult(fmt.Errorf("no tmux pane or tty to match"))
+	}
+	target, err := tmuxPaneForTTY(s.TTY)
+	if err != nil || target == "" {
+		return focusResult(err)
+	}
+	if err := exec.Command("tmux", "select-window", "-t", target).Run(); err != nil {
+		return focusResult(err)
+	}
+	return focusResult(exec.Command("tmux", "select-pane", "-t", target).Run())
+}
+
+// tmuxPaneForTTY looks up the tmux pane target whose tty matches tty.
+func tmuxPaneForTTY(tty string) (string, error) {
+	bare := strings.TrimPrefix(tty, "/dev/")
+	out, err := exec.Command("tmux", "list-panes", "-a", "-F", "#{pane_tty} #{session_name}:#{window_index}.#{pane_index}").Output()
+	if err != nil {
+		return "", err
+	}
+	for _, line := range strings.Split(string(out), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue
+		}
+		if strings.TrimPrefix(fields[0], "/dev/") == bare {
+			return fields[1], nil
+		}
+	}
+	return "", nil
+}
+
+// x11Focuser focuses a window via wmctrl, matching on the cwd basename
+// since X11 window titles are the only thing the monitor can rely on.
+type x11Focuser struct{}
+
+func (x11Focuser) Focus(s Session) (string, error) {
+	name := lastPathComponent(s.CWD)
+	if name == "" {
+		return focusResult(nil)
+	}
+	return focusResult(exec.Command("wmctrl", "-a", name).Run())
+}
+
+// waylandFocuser focuses a window under Sway via swaymsg. Other Wayland
+// compositors have no common equivalent of wmctrl/swaymsg, so this is
+// Sway-specific for now.
+type waylandFocuser struct{}
+
+func (waylandFocuser) Focus(s Session) (string, error) {
+	name := lastPathComponent(s.CWD)
+	if name == "" {
+		return focusResult(nil)
+	}
+	criteria := fmt.Sprintf(`[title="(?i).*%s.*"] focus`, name)
+	return focusResult(exec.Command("swaymsg", criteria).Run())
+}
@@ -0,0 +1,131 @@
+//go:build !integration
+
+package main
+
+import (
+	"errors"
+	"testing"
+)
+
+// fakeSink captures emitted CloudEvents for assertions, without touching a
+// real stdout/webhook/unix-socket sink.
+type fakeSink struct {
+	events []CloudEvent
+}
+
+func (f *fakeSink) Send(ev CloudEvent) error {
+	f.events = append(f.events, ev)
+	return nil
+}
+
+// fakeFocuser is a terminalFocuser stub for exercising FocusSession without
+// shelling out to osascript/wmctrl/etc.
+type fakeFocuser struct {
+	result string
+	err    error
+}
+
+func (f fakeFocuser) Focus(s Session) (string, error) {
+	return f.result, f.err
+}
+
+// TestFocusSession_ReportsBackendResult pins the fix where the emitted
+// dev.claude.focus.attempted event carries the focuser's own reported
+// result (e.g. a Ghostty soft-miss fallback) rather than a generic
+// "success"/"error" derived from a nil err.
+func TestFocusSession_ReportsBackendResult(t *testing.T) {
+	const backendName = "fake-fallback-backend"
+	RegisterTerminalBackend(backendName, func() terminalFocuser {
+		return fakeFocuser{result: "osascript:fallback"}
+	})
+	t.Setenv(terminalEnvOverride, backendName)
+
+	prevBus := globalEventBus
+	sink := &fakeSink{}
+	globalEventBus = NewEventBus("test", sink)
+	defer func() { globalEventBus = prevBus }()
+
+	if err := FocusSession(Session{SessionID: "abc"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(sink.events) != 1 {
+		t.Fatalf("expected 1 event, got %d", len(sink.events))
+	}
+	data, ok := sink.events[0].Data.(FocusAttemptData)
+	if !ok {
+		t.Fatalf("event data is %T, want FocusAttemptData", sink.events[0].Data)
+	}
+	if data.Result != "osascript:fallback" {
+		t.Errorf("Result = %q, want %q", data.Result, "osascript:fallback")
+	}
+	if data.Strategy != backendName {
+		t.Errorf("Strategy = %q, want %q", data.Strategy, backendName)
+	}
+}
+
+// TestFocusSession_PropagatesError checks FocusSession still returns a
+// backend's real error alongside reporting it in the event.
+func TestFocusSession_PropagatesError(t *testing.T) {
+	const backendName = "fake-error-backend"
+	wantErr := errors.New("boom")
+	RegisterTerminalBackend(backendName, func() terminalFocuser {
+		return fakeFocuser{result: "error", err: wantErr}
+	})
+	t.Setenv(terminalEnvOverride, backendName)
+
+	if err := FocusSession(Session{SessionID: "abc"}); !errors.Is(err, wantErr) {
+		t.Errorf("err = %v, want %v", err, wantErr)
+	}
+}
+
+// TestWezTermPaneIDFromJSON_MatchesByCwd pins the switch from a positional
+// substring scan (which assumed pane_id always appeared within three lines
+// before a matching cwd) to a real JSON parse, so field order in wezterm's
+// output can't change the result.
+func TestWezTermPaneIDFromJSON_MatchesByCwd(t *testing.T) {
+	const out = `[
+		{"pane_id": 1, "cwd": "file://host/Users/filip/other-project"},
+		{"pane_id": 2, "cwd": "file://host/Users/filip/claude-monitor"}
+	]`
+	id, err := wezTermPaneIDFromJSON([]byte(out), "/Users/filip/claude-monitor")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if id != "2" {
+		t.Errorf("id = %q, want %q", id, "2")
+	}
+}
+
+// TestWezTermPaneIDFromJSON_FieldOrderIndependent pins the exact ordering
+// issue in the review: pane_id appearing after cwd in the object (or in any
+// order at all) must not affect the match, since a real JSON object has no
+// field order.
+func TestWezTermPaneIDFromJSON_FieldOrderIndependent(t *testing.T) {
+	const out = `[{"cwd": "file://host/Users/filip/claude-monitor", "pane_id": 7}]`
+	id, err := wezTermPaneIDFromJSON([]byte(out), "/Users/filip/claude-monitor")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if id != "7" {
+		t.Errorf("id = %q, want %q", id, "7")
+	}
+}
+
+func TestWezTermPaneIDFromJSON_NoMatch(t *testing.T) {
+	const out = `[{"pane_id": 1, "cwd": "file://host/Users/filip/other-project"}]`
+	id, err := wezTermPaneIDFromJSON([]byte(out), "/Users/filip/claude-monitor")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if id != "" {
+		t.Errorf("id = %q, want empty string", id)
+	}
+}
+
+func TestWezTermPaneIDFromJSON_InvalidJSON(t *testing.T) {
+	_, err := wezTermPaneIDFromJSON([]byte("not json"), "/Users/filip/claude-monitor")
+	if err == nil {
+		t.Error("expected an error for invalid JSON")
+	}
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"os"
+	"strings"
+	"time"
+
+	"github.com/atotto/clipboard"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// transcriptPollInterval controls how often the pane checks the transcript
+// file's mtime for external writes while open.
+const transcriptPollInterval = 1 * time.Second
+
+// transcriptLoadedMsg carries freshly read transcript content back to the model.
+type transcriptLoadedMsg struct {
+	sessionID string
+	path      string
+	content   string
+	mtime     time.Time
+	err       error
+}
+
+// transcriptTickMsg drives the periodic mtime check while the pane is open.
+type transcriptTickMsg struct{}
+
+// cmdLoadTranscript reads path and reports its content and mtime, tagged
+// with sessionID so a stale response can't clobber a pane that has since
+// switched to a different session.
+func cmdLoadTranscript(sessionID, path string) tea.Cmd {
+	return func() tea.Msg {
+		info, err := os.Stat(path)
+		if err != nil {
+			return transcriptLoadedMsg{sessionID: sessionID, path: path, err: err}
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return transcriptLoadedMsg{sessionID: sessionID, path: path, err: err}
+		}
+		return transcriptLoadedMsg{sessionID: sessionID, path: path, content: string(data), mtime: info.ModTime()}
+	}
+}
+
+// cmdTranscriptTick schedules the next periodic mtime check.
+func cmdTranscriptTick() tea.Cmd {
+	return tea.Tick(transcriptPollInterval, func(time.Time) tea.Msg {
+		return transcriptTickMsg{}
+	})
+}
+
+// transcriptMtime returns path's modification time, or the zero time if it
+// cannot be stat'd.
+func transcriptMtime(path string) time.Time {
+	info, err := os.Stat(path)
+	if err != nil {
+		return time.Time{}
+	}
+	return info.ModTime()
+}
+
+// newTranscriptViewport creates a viewport sized for the right-hand pane.
+func newTranscriptViewport(width, height int) viewport.Model {
+	vp := viewport.New(width, height)
+	vp.Style = styleTranscript
+	return vp
+}
+
+// copyTranscriptLine copies the transcript line currently at the top of the
+// viewport to the system clipboard. There is no per-line selection cursor
+// in the pane — up/down are bound to switching which session's transcript
+// is shown, not to moving within it — so "copy" always means the line
+// scrolled to the top, which is what the "y Copy top line" footer hint
+// describes.
+func copyTranscriptLine(vp viewport.Model, lines []string) error {
+	if vp.YOffset < 0 || vp.YOffset >= len(lines) {
+		return nil
+	}
+	return clipboard.WriteAll(lines[vp.YOffset])
+}
+
+// splitTranscriptLines splits raw transcript content into lines for display
+// in the viewport.
+func splitTranscriptLines(content string) []string {
+	if content == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimRight(content, "\n"), "\n")
+}